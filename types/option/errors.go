@@ -0,0 +1,115 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Sentinel errors returned (wrapped with fmt.Errorf's %w) by value
+// resolution and validation throughout this package. Callers should use
+// errors.Is/errors.As, or the IsMissingDependency helper, rather than
+// matching on error strings.
+var (
+	// ErrMissingConfigMap indicates the referenced ConfigMap has no entry
+	// in DependentValues yet.
+	ErrMissingConfigMap = errors.New("ConfigMap does not exist in DependentValues")
+
+	// ErrMissingSecret indicates the referenced Secret has no entry in
+	// DependentValues yet.
+	ErrMissingSecret = errors.New("Secret does not exist in DependentValues")
+
+	// ErrMissingKey indicates a referenced ConfigMap or Secret exists in
+	// DependentValues but does not contain the requested key.
+	ErrMissingKey = errors.New("key does not exist in DependentValues")
+
+	// ErrMissingKrakenKind indicates no KrakenResource of the referenced
+	// kind has been fetched into DependentValues yet.
+	ErrMissingKrakenKind = errors.New("kind does not exist in DependentValues")
+
+	// ErrMissingKrakenName indicates no KrakenResource of the referenced
+	// kind/name has been fetched into DependentValues yet.
+	ErrMissingKrakenName = errors.New("resource does not exist in DependentValues")
+
+	// ErrMissingKrakenPath indicates a fetched KrakenResource exists in
+	// DependentValues but the referenced path has no entry yet.
+	ErrMissingKrakenPath = errors.New("path does not exist in DependentValues")
+
+	// ErrEmptyField indicates a required reference field (e.g. a ConfigMap
+	// name or key) was left empty.
+	ErrEmptyField = errors.New("required field cannot be empty")
+
+	// ErrUnknownPathSyntax indicates a ValueFromKrakenResource.PathSyntax
+	// was set to a value other than the ones this package knows how to
+	// evaluate.
+	ErrUnknownPathSyntax = errors.New("unknown path syntax")
+
+	// ErrBothValueAndRef indicates both a concrete Value and a value
+	// reference (ValueFrom or ValueFromChain) were set, or a single
+	// ValueFrom set more than one source, which is never valid.
+	ErrBothValueAndRef = errors.New("both value reference and concrete value provided")
+
+	// ErrNoValueOrRef indicates neither a concrete Value nor any value
+	// reference was set, and there is no Default to fall back to.
+	ErrNoValueOrRef = errors.New("neither value reference nor concrete value provided")
+
+	// ErrUnsupportedSource indicates a ValueFrom referenced a source kind
+	// (ConfigMap, Secret, Expression) that the option type cannot resolve
+	// against, such as a StringList or StringMap sourced from anything
+	// other than a KrakenResource.
+	ErrUnsupportedSource = errors.New("value source is not supported by this option type")
+)
+
+// ErrTypeMismatch indicates a resolved value's Go kind did not match the
+// kind the option type expected.
+type ErrTypeMismatch struct {
+	Expected reflect.Kind
+	Actual   reflect.Kind
+}
+
+func (e *ErrTypeMismatch) Error() string {
+	return fmt.Sprintf("expected type \"%s\" but got type \"%s\"", e.Expected, e.Actual)
+}
+
+// Is lets errors.Is match a zero-value *ErrTypeMismatch (errors.Is(err,
+// &ErrTypeMismatch{})) as a wildcard for "any type mismatch", in addition to
+// the usual exact-match comparison.
+func (e *ErrTypeMismatch) Is(target error) bool {
+	other, ok := target.(*ErrTypeMismatch)
+	if !ok {
+		return false
+	}
+	return (other.Expected == reflect.Invalid || other.Expected == e.Expected) &&
+		(other.Actual == reflect.Invalid || other.Actual == e.Actual)
+}
+
+// IsMissingDependency reports whether err indicates that resolution failed
+// because backing data (a ConfigMap, Secret, or KrakenResource) has not
+// been fetched into DependentValues yet, as opposed to a user
+// misconfiguration such as a type mismatch or an empty required field.
+// Controllers can use this to decide whether to requeue and wait, or to
+// surface the error on status instead.
+func IsMissingDependency(err error) bool {
+	return errors.Is(err, ErrMissingConfigMap) ||
+		errors.Is(err, ErrMissingSecret) ||
+		errors.Is(err, ErrMissingKey) ||
+		errors.Is(err, ErrMissingKrakenKind) ||
+		errors.Is(err, ErrMissingKrakenName) ||
+		errors.Is(err, ErrMissingKrakenPath)
+}
@@ -21,19 +21,71 @@ package option
 // TODO: Move this package into a shared types repo
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/Jeffail/gabs/v2"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
 	krakenv1alpha1 "github.com/kraken-iac/kraken/api/v1alpha1"
+	"k8s.io/client-go/util/jsonpath"
 )
 
-var (
-	errValidationNoValueOrRef    = errors.New("neither value reference nor concrete value provided")
-	errValidationBothValueAndRef = errors.New("both value reference and concrete value provided")
-)
+// validateValue applies the common Value/ValueFrom XOR rule shared by every
+// option type: exactly one of a concrete value or a value reference must be
+// set, and a set reference must itself be valid.
+func validateValue(hasValue bool, valueFrom *ValueFrom) error {
+	if hasValue {
+		if valueFrom != nil {
+			return ErrBothValueAndRef
+		}
+		return nil
+	}
+	if valueFrom == nil {
+		return ErrNoValueOrRef
+	}
+	return valueFrom.Validate()
+}
+
+// validateValueChain is validateValue extended with the fallback-chain and
+// default fields supported by String and the numeric/bool/duration option
+// types: Value remains mutually exclusive with both ValueFrom and
+// ValueFromChain, every chain entry is validated individually, and a Default
+// makes the whole option valid even with no value or reference at all.
+func validateValueChain(hasValue bool, valueFrom *ValueFrom, chain []ValueFrom, hasDefault bool) error {
+	if hasValue {
+		if valueFrom != nil || len(chain) > 0 {
+			return ErrBothValueAndRef
+		}
+		return nil
+	}
+	if valueFrom != nil {
+		if err := valueFrom.Validate(); err != nil {
+			return err
+		}
+	}
+	for i, vf := range chain {
+		if err := vf.Validate(); err != nil {
+			return fmt.Errorf("valueFromChain[%d]: %w", i, err)
+		}
+	}
+	if valueFrom == nil && len(chain) == 0 && !hasDefault {
+		return ErrNoValueOrRef
+	}
+	return nil
+}
+
+// kindOf is reflect.TypeOf(v).Kind(), except it reports reflect.Invalid
+// instead of panicking when v is nil (as a KrakenResource value that is
+// JSON null is).
+func kindOf(v any) reflect.Kind {
+	if v == nil {
+		return reflect.Invalid
+	}
+	return reflect.TypeOf(v).Kind()
+}
 
 type ValueFromConfigMap struct {
 	// +kubebuilder:validation:Required
@@ -52,10 +104,10 @@ func (vfcm ValueFromConfigMap) ToConfigMapDependency() krakenv1alpha1.ConfigMapD
 
 func (vfcm ValueFromConfigMap) Validate() error {
 	if vfcm.Name == "" {
-		return errors.New("ConfigMap name cannot be empty")
+		return fmt.Errorf("ConfigMap name: %w", ErrEmptyField)
 	}
 	if vfcm.Key == "" {
-		return errors.New("ConfigMap key cannot be empty")
+		return fmt.Errorf("ConfigMap key: %w", ErrEmptyField)
 	}
 	return nil
 }
@@ -68,10 +120,40 @@ type ValueFromSecret struct {
 	Key string `json:"key"`
 }
 
-func (vfs ValueFromSecret) ToSecretDependency() {
-	panic("Not implemented")
+func (vfs ValueFromSecret) Validate() error {
+	if vfs.Name == "" {
+		return fmt.Errorf("Secret name: %w", ErrEmptyField)
+	}
+	if vfs.Key == "" {
+		return fmt.Errorf("Secret key: %w", ErrEmptyField)
+	}
+	return nil
 }
 
+// PathSyntax selects how ValueFromKrakenResource.Path is interpreted.
+type PathSyntax string
+
+const (
+	// PathSyntaxKey treats Path as a flat key into the pre-computed
+	// DependentValues map for the resource (the historical behavior).
+	PathSyntaxKey PathSyntax = "key"
+	// PathSyntaxJSONPointer treats Path as an RFC 6901 JSON Pointer
+	// evaluated against the resource's whole JSON at read time.
+	PathSyntaxJSONPointer PathSyntax = "jsonpointer"
+	// PathSyntaxGabs treats Path as a gabs dot-path evaluated against the
+	// resource's whole JSON at read time.
+	PathSyntaxGabs PathSyntax = "gabs"
+	// PathSyntaxJSONPath treats Path as a k8s.io/client-go JSONPath
+	// template (e.g. "{.spec.replicas}") evaluated against the resource's
+	// whole JSON at read time.
+	PathSyntaxJSONPath PathSyntax = "jsonpath"
+)
+
+// wholeResourceKey is the DependentValues lookup key under which the
+// controller stores a resource's entire JSON for path syntaxes that can't be
+// resolved to a flat key ahead of time.
+const wholeResourceKey = ""
+
 type ValueFromKrakenResource struct {
 	// +kubebuilder:validation:Required
 	Kind string `json:"kind"`
@@ -81,13 +163,53 @@ type ValueFromKrakenResource struct {
 
 	// +kubebuilder:validation:Required
 	Path string `json:"path"`
+
+	// PathSyntax controls how Path is evaluated. Defaults to PathSyntaxKey.
+	// +kubebuilder:validation:Enum=key;jsonpointer;gabs;jsonpath
+	// +kubebuilder:default=key
+	PathSyntax PathSyntax `json:"pathSyntax,omitempty"`
+}
+
+func (vfkr ValueFromKrakenResource) effectivePathSyntax() PathSyntax {
+	if vfkr.PathSyntax == "" {
+		return PathSyntaxKey
+	}
+	return vfkr.PathSyntax
+}
+
+func (vfkr ValueFromKrakenResource) Validate() error {
+	if vfkr.Kind == "" {
+		return fmt.Errorf("KrakenResource kind: %w", ErrEmptyField)
+	}
+	if vfkr.Name == "" {
+		return fmt.Errorf("KrakenResource name: %w", ErrEmptyField)
+	}
+	if vfkr.Path == "" {
+		return fmt.Errorf("KrakenResource path: %w", ErrEmptyField)
+	}
+	switch vfkr.effectivePathSyntax() {
+	case PathSyntaxKey, PathSyntaxJSONPointer, PathSyntaxGabs, PathSyntaxJSONPath:
+		return nil
+	default:
+		return fmt.Errorf("\"%s\": %w", vfkr.PathSyntax, ErrUnknownPathSyntax)
+	}
 }
 
 func (vfkr ValueFromKrakenResource) ToKrakenResourceDependency(kind reflect.Kind) krakenv1alpha1.KrakenResourceDependency {
+	path := vfkr.Path
+	// Non-key syntaxes evaluate against the resource's whole JSON at read
+	// time, so the controller can't pre-compute a flat path to fetch.
+	// wholeResourceKey ("") is the RFC 6901 JSON Pointer for "the whole
+	// document", so registering it as the dependency's Path asks the
+	// controller to fetch (and keep fresh) the entire object instead,
+	// without requiring a dedicated field on KrakenResourceDependency.
+	if vfkr.effectivePathSyntax() != PathSyntaxKey {
+		path = wholeResourceKey
+	}
 	return krakenv1alpha1.KrakenResourceDependency{
 		Kind:        vfkr.Kind,
 		Name:        vfkr.Name,
-		Path:        vfkr.Path,
+		Path:        path,
 		ReflectKind: kind,
 	}
 }
@@ -96,6 +218,7 @@ type ValueFrom struct {
 	ConfigMap      *ValueFromConfigMap      `json:"configMap,omitempty"`
 	Secret         *ValueFromSecret         `json:"secret,omitempty"`
 	KrakenResource *ValueFromKrakenResource `json:"krakenResource,omitempty"`
+	Expression     *ValueFromExpression     `json:"expression,omitempty"`
 }
 
 func (vf ValueFrom) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec, kind reflect.Kind) {
@@ -105,8 +228,11 @@ func (vf ValueFrom) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequ
 	if vf.ConfigMap != nil {
 		dr.ConfigMapDependencies = append(dr.ConfigMapDependencies, vf.ConfigMap.ToConfigMapDependency())
 	}
-	if vf.Secret != nil {
-		panic("Unimplemented")
+	// Secret has no counterpart on DependencyRequestSpec yet, so there is
+	// nothing to register here; resolving a Secret source fails with
+	// ErrUnsupportedSource until that lands upstream.
+	if vf.Expression != nil {
+		vf.Expression.AddToDependencyRequestSpec(dr)
 	}
 }
 
@@ -121,61 +247,237 @@ func (vf ValueFrom) Validate() error {
 	if vf.KrakenResource != nil {
 		nonNilCount++
 	}
-	if nonNilCount != 1 {
-		return fmt.Errorf("expected a single value reference but received %d", nonNilCount)
+	if vf.Expression != nil {
+		nonNilCount++
+	}
+	if nonNilCount == 0 {
+		return ErrNoValueOrRef
+	}
+	if nonNilCount > 1 {
+		return ErrBothValueAndRef
+	}
+	if vf.ConfigMap != nil {
+		return vf.ConfigMap.Validate()
+	}
+	if vf.Secret != nil {
+		return vf.Secret.Validate()
+	}
+	if vf.KrakenResource != nil {
+		return vf.KrakenResource.Validate()
+	}
+	return vf.Expression.Validate()
+}
+
+// resolveDynamic resolves this ValueFrom to an untyped Go value, for use as a
+// named input to a CEL expression. Unlike ToApplicableValue on the option
+// types, it has no expected Go type to coerce into.
+func (vf ValueFrom) resolveDynamic(dv krakenv1alpha1.DependentValues) (any, error) {
+	if vf.ConfigMap != nil {
+		val, err := getValueFromConfigMap(vf.ConfigMap, dv.FromConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+		return *val, nil
+	}
+	if vf.Secret != nil {
+		val, err := getValueFromSecret(vf.Secret)
+		if err != nil {
+			return nil, err
+		}
+		return *val, nil
+	}
+	if vf.KrakenResource != nil {
+		return getRawValueFromKrakenResource(vf.KrakenResource, dv.FromKrakenResources)
+	}
+	if vf.Expression != nil {
+		out, err := vf.Expression.Resolve(dv)
+		if err != nil {
+			return nil, err
+		}
+		return out.Value(), nil
+	}
+	return nil, ErrNoValueOrRef
+}
+
+type ValueFromExpression struct {
+	// CEL expression evaluated against Inputs to produce the resolved value.
+	// +kubebuilder:validation:Required
+	Expression string `json:"expression"`
+
+	// Named inputs bound as CEL variables before Expression is evaluated.
+	// +kubebuilder:validation:Required
+	Inputs map[string]ValueFrom `json:"inputs"`
+}
+
+func (vfe ValueFromExpression) Validate() error {
+	if vfe.Expression == "" {
+		return fmt.Errorf("Expression: %w", ErrEmptyField)
+	}
+	for name, input := range vfe.Inputs {
+		if err := input.Validate(); err != nil {
+			return fmt.Errorf("input \"%s\": %w", name, err)
+		}
 	}
 	return nil
 }
 
+func (vfe ValueFromExpression) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	for _, input := range vfe.Inputs {
+		// The expected Go kind of an input is not known until the expression
+		// is evaluated, so request the input's data without narrowing it.
+		input.AddToDependencyRequestSpec(dr, reflect.Interface)
+	}
+}
+
+// Resolve binds each of vfe.Inputs as a CEL variable and evaluates
+// vfe.Expression against them.
+func (vfe ValueFromExpression) Resolve(dv krakenv1alpha1.DependentValues) (ref.Val, error) {
+	vars := make(map[string]any, len(vfe.Inputs))
+	decls := make([]cel.EnvOption, 0, len(vfe.Inputs))
+	for name, input := range vfe.Inputs {
+		val, err := input.resolveDynamic(dv)
+		if err != nil {
+			return nil, fmt.Errorf("resolving CEL input \"%s\": %w", name, err)
+		}
+		vars[name] = val
+		decls = append(decls, cel.Variable(name, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(decls...)
+	if err != nil {
+		return nil, fmt.Errorf("building CEL environment: %s", err)
+	}
+	ast, iss := env.Compile(vfe.Expression)
+	if iss != nil && iss.Err() != nil {
+		return nil, fmt.Errorf("compiling CEL expression \"%s\": %s", vfe.Expression, iss.Err())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("building CEL program for expression \"%s\": %s", vfe.Expression, err)
+	}
+	out, _, err := prg.Eval(vars)
+	if err != nil {
+		return nil, fmt.Errorf("evaluating CEL expression \"%s\": %s", vfe.Expression, err)
+	}
+	return out, nil
+}
+
 type String struct {
 	Value     *string    `json:"value,omitempty"`
 	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+
+	// ValueFromChain is tried, in order, when ValueFrom is unset. Entries
+	// whose backing data is absent are skipped rather than treated as an
+	// error.
+	ValueFromChain []ValueFrom `json:"valueFromChain,omitempty"`
+
+	// Default is used if Value and ValueFrom are unset and no ValueFromChain
+	// entry yielded a value. An error from ValueFrom is returned as is.
+	Default *string `json:"default,omitempty"`
+}
+
+func resolveString(vf *ValueFrom, dv krakenv1alpha1.DependentValues) (*string, error) {
+	if vf == nil {
+		return nil, nil
+	}
+	if vf.ConfigMap != nil {
+		return getValueFromConfigMap(vf.ConfigMap, dv.FromConfigMaps)
+	}
+	if vf.Secret != nil {
+		return getValueFromSecret(vf.Secret)
+	}
+	if vf.KrakenResource != nil {
+		return getValueFromKrakenResource[string](vf.KrakenResource, dv.FromKrakenResources)
+	}
+	if vf.Expression != nil {
+		out, err := vf.Expression.Resolve(dv)
+		if err != nil {
+			return nil, err
+		}
+		val, ok := out.Value().(string)
+		if !ok {
+			return nil, fmt.Errorf(
+				"CEL expression result \"%v\": %w",
+				out.Value(),
+				&ErrTypeMismatch{Expected: reflect.String, Actual: kindOf(out.Value())},
+			)
+		}
+		return &val, nil
+	}
+	return nil, ErrNoValueOrRef
 }
 
 func (s String) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*string, error) {
 	if s.Value != nil {
 		return s.Value, nil
 	}
-	if s.ValueFrom == nil {
-		return nil, nil
+	if val, err := resolveString(s.ValueFrom, dv); err != nil || val != nil {
+		return val, err
 	}
-	if s.ValueFrom.ConfigMap != nil {
-		return getValueFromConfigMap(s.ValueFrom.ConfigMap, dv.FromConfigMaps)
+	for i := range s.ValueFromChain {
+		val, err := resolveString(&s.ValueFromChain[i], dv)
+		if err != nil {
+			if IsMissingDependency(err) {
+				// Backing data not available yet; fall through to the
+				// next chain entry instead of failing resolution outright.
+				continue
+			}
+			return nil, err
+		}
+		if val != nil {
+			return val, nil
+		}
 	}
-	if s.ValueFrom.KrakenResource != nil {
-		return getValueFromKrakenResource[string](s.ValueFrom.KrakenResource, dv.FromKrakenResources)
+	if s.Default != nil {
+		return s.Default, nil
 	}
-	return nil, errors.New("ValueFrom object is not nil but does not contain any non-nil pointer references")
+	return nil, nil
 }
 
 func (s String) Validate() error {
-	if s.Value != nil {
-		if s.ValueFrom != nil {
-			return errValidationBothValueAndRef
-		}
-		return nil
-	} else {
-		if s.ValueFrom == nil {
-			return errValidationNoValueOrRef
-		}
+	return validateValueChain(s.Value != nil, s.ValueFrom, s.ValueFromChain, s.Default != nil)
+}
+
+func (s String) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	if s.ValueFrom != nil {
+		s.ValueFrom.AddToDependencyRequestSpec(dr, reflect.String)
+	}
+	for _, vf := range s.ValueFromChain {
+		vf.AddToDependencyRequestSpec(dr, reflect.String)
 	}
-	return s.ValueFrom.Validate()
 }
 
 type Int struct {
 	Value     *int       `json:"value,omitempty"`
 	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+
+	// ValueFromChain is tried, in order, when ValueFrom is unset. Entries
+	// whose backing data is absent are skipped rather than treated as an
+	// error.
+	ValueFromChain []ValueFrom `json:"valueFromChain,omitempty"`
+
+	// Default is used if Value and ValueFrom are unset and no ValueFromChain
+	// entry yielded a value. An error from ValueFrom is returned as is.
+	Default *int `json:"default,omitempty"`
 }
 
-func (i Int) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*int, error) {
-	if i.Value != nil {
-		return i.Value, nil
-	}
-	if i.ValueFrom == nil {
+func resolveInt(vf *ValueFrom, dv krakenv1alpha1.DependentValues) (*int, error) {
+	if vf == nil {
 		return nil, nil
 	}
-	if i.ValueFrom.ConfigMap != nil {
-		valString, err := getValueFromConfigMap(i.ValueFrom.ConfigMap, dv.FromConfigMaps)
+	if vf.ConfigMap != nil {
+		valString, err := getValueFromConfigMap(vf.ConfigMap, dv.FromConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+		val, err := strconv.Atoi(*valString)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.Secret != nil {
+		valString, err := getValueFromSecret(vf.Secret)
 		if err != nil {
 			return nil, err
 		}
@@ -185,76 +487,646 @@ func (i Int) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*int, error)
 		}
 		return &val, nil
 	}
-	if i.ValueFrom.KrakenResource != nil {
+	if vf.KrakenResource != nil {
 		// Unmarshalled JSON numbers are of type float64
-		valFloat, err := getValueFromKrakenResource[float64](i.ValueFrom.KrakenResource, dv.FromKrakenResources)
+		valFloat, err := getValueFromKrakenResource[float64](vf.KrakenResource, dv.FromKrakenResources)
 		if err != nil {
 			return nil, err
 		}
 		val := int(*valFloat)
 		return &val, nil
 	}
-	return nil, errors.New("ValueFrom object is not nil but does not contain any non-nil pointer references")
+	if vf.Expression != nil {
+		out, err := vf.Expression.Resolve(dv)
+		if err != nil {
+			return nil, err
+		}
+		switch result := out.Value().(type) {
+		case int64:
+			val := int(result)
+			return &val, nil
+		case float64:
+			val := int(result)
+			return &val, nil
+		default:
+			return nil, fmt.Errorf(
+				"CEL expression result \"%v\": %w",
+				result,
+				&ErrTypeMismatch{Expected: reflect.Int64, Actual: kindOf(result)},
+			)
+		}
+	}
+	return nil, ErrNoValueOrRef
 }
 
-func (i Int) Validate() error {
+func (i Int) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*int, error) {
 	if i.Value != nil {
-		if i.ValueFrom != nil {
-			return errValidationBothValueAndRef
+		return i.Value, nil
+	}
+	if val, err := resolveInt(i.ValueFrom, dv); err != nil || val != nil {
+		return val, err
+	}
+	for idx := range i.ValueFromChain {
+		val, err := resolveInt(&i.ValueFromChain[idx], dv)
+		if err != nil {
+			if IsMissingDependency(err) {
+				continue
+			}
+			return nil, err
 		}
-		return nil
-	} else {
-		if i.ValueFrom == nil {
-			return errValidationNoValueOrRef
+		if val != nil {
+			return val, nil
+		}
+	}
+	if i.Default != nil {
+		return i.Default, nil
+	}
+	return nil, nil
+}
+
+func (i Int) Validate() error {
+	return validateValueChain(i.Value != nil, i.ValueFrom, i.ValueFromChain, i.Default != nil)
+}
+
+func (i Int) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	if i.ValueFrom != nil {
+		i.ValueFrom.AddToDependencyRequestSpec(dr, reflect.Int)
+	}
+	for _, vf := range i.ValueFromChain {
+		vf.AddToDependencyRequestSpec(dr, reflect.Int)
+	}
+}
+
+type Bool struct {
+	Value     *bool      `json:"value,omitempty"`
+	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+
+	// ValueFromChain is tried, in order, when ValueFrom is unset. Entries
+	// whose backing data is absent are skipped rather than treated as an
+	// error.
+	ValueFromChain []ValueFrom `json:"valueFromChain,omitempty"`
+
+	// Default is used if Value and ValueFrom are unset and no ValueFromChain
+	// entry yielded a value. An error from ValueFrom is returned as is.
+	Default *bool `json:"default,omitempty"`
+}
+
+func resolveBool(vf *ValueFrom, dv krakenv1alpha1.DependentValues) (*bool, error) {
+	if vf == nil {
+		return nil, nil
+	}
+	if vf.ConfigMap != nil {
+		valString, err := getValueFromConfigMap(vf.ConfigMap, dv.FromConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+		val, err := strconv.ParseBool(*valString)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.Secret != nil {
+		valString, err := getValueFromSecret(vf.Secret)
+		if err != nil {
+			return nil, err
+		}
+		val, err := strconv.ParseBool(*valString)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.KrakenResource != nil {
+		return getValueFromKrakenResource[bool](vf.KrakenResource, dv.FromKrakenResources)
+	}
+	if vf.Expression != nil {
+		out, err := vf.Expression.Resolve(dv)
+		if err != nil {
+			return nil, err
+		}
+		val, ok := out.Value().(bool)
+		if !ok {
+			return nil, fmt.Errorf(
+				"CEL expression result \"%v\": %w",
+				out.Value(),
+				&ErrTypeMismatch{Expected: reflect.Bool, Actual: kindOf(out.Value())},
+			)
+		}
+		return &val, nil
+	}
+	return nil, ErrNoValueOrRef
+}
+
+func (b Bool) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*bool, error) {
+	if b.Value != nil {
+		return b.Value, nil
+	}
+	if val, err := resolveBool(b.ValueFrom, dv); err != nil || val != nil {
+		return val, err
+	}
+	for i := range b.ValueFromChain {
+		val, err := resolveBool(&b.ValueFromChain[i], dv)
+		if err != nil {
+			if IsMissingDependency(err) {
+				continue
+			}
+			return nil, err
+		}
+		if val != nil {
+			return val, nil
+		}
+	}
+	if b.Default != nil {
+		return b.Default, nil
+	}
+	return nil, nil
+}
+
+func (b Bool) Validate() error {
+	return validateValueChain(b.Value != nil, b.ValueFrom, b.ValueFromChain, b.Default != nil)
+}
+
+func (b Bool) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	if b.ValueFrom != nil {
+		b.ValueFrom.AddToDependencyRequestSpec(dr, reflect.Bool)
+	}
+	for _, vf := range b.ValueFromChain {
+		vf.AddToDependencyRequestSpec(dr, reflect.Bool)
+	}
+}
+
+type Float64 struct {
+	Value     *float64   `json:"value,omitempty"`
+	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+
+	// ValueFromChain is tried, in order, when ValueFrom is unset. Entries
+	// whose backing data is absent are skipped rather than treated as an
+	// error.
+	ValueFromChain []ValueFrom `json:"valueFromChain,omitempty"`
+
+	// Default is used if Value and ValueFrom are unset and no ValueFromChain
+	// entry yielded a value. An error from ValueFrom is returned as is.
+	Default *float64 `json:"default,omitempty"`
+}
+
+func resolveFloat64(vf *ValueFrom, dv krakenv1alpha1.DependentValues) (*float64, error) {
+	if vf == nil {
+		return nil, nil
+	}
+	if vf.ConfigMap != nil {
+		valString, err := getValueFromConfigMap(vf.ConfigMap, dv.FromConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+		val, err := strconv.ParseFloat(*valString, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.Secret != nil {
+		valString, err := getValueFromSecret(vf.Secret)
+		if err != nil {
+			return nil, err
+		}
+		val, err := strconv.ParseFloat(*valString, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.KrakenResource != nil {
+		return getValueFromKrakenResource[float64](vf.KrakenResource, dv.FromKrakenResources)
+	}
+	if vf.Expression != nil {
+		out, err := vf.Expression.Resolve(dv)
+		if err != nil {
+			return nil, err
+		}
+		switch result := out.Value().(type) {
+		case float64:
+			return &result, nil
+		case int64:
+			val := float64(result)
+			return &val, nil
+		default:
+			return nil, fmt.Errorf(
+				"CEL expression result \"%v\": %w",
+				result,
+				&ErrTypeMismatch{Expected: reflect.Float64, Actual: kindOf(result)},
+			)
+		}
+	}
+	return nil, ErrNoValueOrRef
+}
+
+func (f Float64) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*float64, error) {
+	if f.Value != nil {
+		return f.Value, nil
+	}
+	if val, err := resolveFloat64(f.ValueFrom, dv); err != nil || val != nil {
+		return val, err
+	}
+	for i := range f.ValueFromChain {
+		val, err := resolveFloat64(&f.ValueFromChain[i], dv)
+		if err != nil {
+			if IsMissingDependency(err) {
+				continue
+			}
+			return nil, err
+		}
+		if val != nil {
+			return val, nil
+		}
+	}
+	if f.Default != nil {
+		return f.Default, nil
+	}
+	return nil, nil
+}
+
+func (f Float64) Validate() error {
+	return validateValueChain(f.Value != nil, f.ValueFrom, f.ValueFromChain, f.Default != nil)
+}
+
+func (f Float64) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	if f.ValueFrom != nil {
+		f.ValueFrom.AddToDependencyRequestSpec(dr, reflect.Float64)
+	}
+	for _, vf := range f.ValueFromChain {
+		vf.AddToDependencyRequestSpec(dr, reflect.Float64)
+	}
+}
+
+type Duration struct {
+	Value     *string    `json:"value,omitempty"`
+	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+
+	// ValueFromChain is tried, in order, when ValueFrom is unset. Entries
+	// whose backing data is absent are skipped rather than treated as an
+	// error.
+	ValueFromChain []ValueFrom `json:"valueFromChain,omitempty"`
+
+	// Default is used if Value and ValueFrom are unset and no ValueFromChain
+	// entry yielded a value. An error from ValueFrom is returned as is.
+	Default *string `json:"default,omitempty"`
+}
+
+func resolveDuration(vf *ValueFrom, dv krakenv1alpha1.DependentValues) (*time.Duration, error) {
+	if vf == nil {
+		return nil, nil
+	}
+	if vf.ConfigMap != nil {
+		valString, err := getValueFromConfigMap(vf.ConfigMap, dv.FromConfigMaps)
+		if err != nil {
+			return nil, err
+		}
+		val, err := time.ParseDuration(*valString)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.Secret != nil {
+		valString, err := getValueFromSecret(vf.Secret)
+		if err != nil {
+			return nil, err
+		}
+		val, err := time.ParseDuration(*valString)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if vf.KrakenResource != nil {
+		// Unmarshalled JSON numbers are of type float64; treat them as a
+		// count of seconds so authors can write e.g. `1.5` for 1500ms.
+		valSeconds, err := getValueFromKrakenResource[float64](vf.KrakenResource, dv.FromKrakenResources)
+		if err != nil {
+			return nil, err
+		}
+		val := time.Duration(*valSeconds * float64(time.Second))
+		return &val, nil
+	}
+	if vf.Expression != nil {
+		out, err := vf.Expression.Resolve(dv)
+		if err != nil {
+			return nil, err
+		}
+		switch result := out.Value().(type) {
+		case string:
+			val, err := time.ParseDuration(result)
+			if err != nil {
+				return nil, err
+			}
+			return &val, nil
+		case float64:
+			val := time.Duration(result * float64(time.Second))
+			return &val, nil
+		case int64:
+			val := time.Duration(float64(result) * float64(time.Second))
+			return &val, nil
+		default:
+			return nil, fmt.Errorf(
+				"CEL expression result \"%v\": %w",
+				result,
+				&ErrTypeMismatch{Expected: reflect.Int64, Actual: kindOf(result)},
+			)
+		}
+	}
+	return nil, ErrNoValueOrRef
+}
+
+func (d Duration) ToApplicableValue(dv krakenv1alpha1.DependentValues) (*time.Duration, error) {
+	if d.Value != nil {
+		val, err := time.ParseDuration(*d.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &val, nil
+	}
+	if val, err := resolveDuration(d.ValueFrom, dv); err != nil || val != nil {
+		return val, err
+	}
+	for i := range d.ValueFromChain {
+		val, err := resolveDuration(&d.ValueFromChain[i], dv)
+		if err != nil {
+			if IsMissingDependency(err) {
+				continue
+			}
+			return nil, err
+		}
+		if val != nil {
+			return val, nil
+		}
+	}
+	if d.Default != nil {
+		val, err := time.ParseDuration(*d.Default)
+		if err != nil {
+			return nil, err
 		}
+		return &val, nil
+	}
+	return nil, nil
+}
+
+func (d Duration) Validate() error {
+	if d.Value != nil {
+		if _, err := time.ParseDuration(*d.Value); err != nil {
+			return err
+		}
+	}
+	if d.Default != nil {
+		if _, err := time.ParseDuration(*d.Default); err != nil {
+			return err
+		}
+	}
+	return validateValueChain(d.Value != nil, d.ValueFrom, d.ValueFromChain, d.Default != nil)
+}
+
+func (d Duration) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	// time.Duration is an int64 underneath; register that as the resolved
+	// kind regardless of the textual/numeric form the source data is in.
+	if d.ValueFrom != nil {
+		d.ValueFrom.AddToDependencyRequestSpec(dr, reflect.Int64)
+	}
+	for _, vf := range d.ValueFromChain {
+		vf.AddToDependencyRequestSpec(dr, reflect.Int64)
+	}
+}
+
+type StringList struct {
+	Value     []string   `json:"value,omitempty"`
+	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+}
+
+func (sl StringList) ToApplicableValue(dv krakenv1alpha1.DependentValues) ([]string, error) {
+	if sl.Value != nil {
+		return sl.Value, nil
+	}
+	if sl.ValueFrom == nil {
+		return nil, nil
+	}
+	if sl.ValueFrom.KrakenResource != nil {
+		data, err := getRawValueFromKrakenResource(sl.ValueFrom.KrakenResource, dv.FromKrakenResources)
+		if err != nil {
+			return nil, err
+		}
+		items, ok := data.([]any)
+		if !ok {
+			return nil, fmt.Errorf(
+				"provided value \"%v\": %w",
+				data,
+				&ErrTypeMismatch{Expected: reflect.Slice, Actual: kindOf(data)},
+			)
+		}
+		val := make([]string, len(items))
+		for idx, item := range items {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"element %d of provided array \"%v\": %w",
+					idx, data,
+					&ErrTypeMismatch{Expected: reflect.String, Actual: kindOf(item)},
+				)
+			}
+			val[idx] = str
+		}
+		return val, nil
+	}
+	return nil, fmt.Errorf("StringList can only be sourced from a KrakenResource value reference: %w", ErrUnsupportedSource)
+}
+
+func (sl StringList) Validate() error {
+	if err := validateValue(sl.Value != nil, sl.ValueFrom); err != nil {
+		return err
+	}
+	if sl.ValueFrom != nil && sl.ValueFrom.KrakenResource == nil {
+		return fmt.Errorf("StringList: %w", ErrUnsupportedSource)
+	}
+	return nil
+}
+
+func (sl StringList) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	if sl.ValueFrom != nil {
+		sl.ValueFrom.AddToDependencyRequestSpec(dr, reflect.Slice)
+	}
+}
+
+type StringMap struct {
+	Value     map[string]string `json:"value,omitempty"`
+	ValueFrom *ValueFrom        `json:"valueFrom,omitempty"`
+}
+
+func (sm StringMap) ToApplicableValue(dv krakenv1alpha1.DependentValues) (map[string]string, error) {
+	if sm.Value != nil {
+		return sm.Value, nil
+	}
+	if sm.ValueFrom == nil {
+		return nil, nil
+	}
+	if sm.ValueFrom.KrakenResource != nil {
+		data, err := getRawValueFromKrakenResource(sm.ValueFrom.KrakenResource, dv.FromKrakenResources)
+		if err != nil {
+			return nil, err
+		}
+		obj, ok := data.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(
+				"provided value \"%v\": %w",
+				data,
+				&ErrTypeMismatch{Expected: reflect.Map, Actual: kindOf(data)},
+			)
+		}
+		val := make(map[string]string, len(obj))
+		for key, item := range obj {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"value for key \"%s\" of provided object \"%v\": %w",
+					key, data,
+					&ErrTypeMismatch{Expected: reflect.String, Actual: kindOf(item)},
+				)
+			}
+			val[key] = str
+		}
+		return val, nil
+	}
+	return nil, fmt.Errorf("StringMap can only be sourced from a KrakenResource value reference: %w", ErrUnsupportedSource)
+}
+
+func (sm StringMap) Validate() error {
+	if err := validateValue(sm.Value != nil, sm.ValueFrom); err != nil {
+		return err
+	}
+	if sm.ValueFrom != nil && sm.ValueFrom.KrakenResource == nil {
+		return fmt.Errorf("StringMap: %w", ErrUnsupportedSource)
+	}
+	return nil
+}
+
+func (sm StringMap) AddToDependencyRequestSpec(dr *krakenv1alpha1.DependencyRequestSpec) {
+	if sm.ValueFrom != nil {
+		sm.ValueFrom.AddToDependencyRequestSpec(dr, reflect.Map)
 	}
-	return i.ValueFrom.Validate()
 }
 
 func getValueFromConfigMap(cmRef *ValueFromConfigMap, cmVals krakenv1alpha1.DependentValuesFromConfigMaps) (*string, error) {
 	cm, exists := cmVals[cmRef.Name]
 	if !exists {
-		return nil, fmt.Errorf("ConfigMap \"%s\" does not exist in DependentValues", cmRef.Name)
+		return nil, fmt.Errorf("ConfigMap \"%s\": %w", cmRef.Name, ErrMissingConfigMap)
 	}
 	val, exists := cm[cmRef.Key]
 	if !exists {
-		return nil, fmt.Errorf("key \"%s\" does not exist in DependentValues ConfigMap \"%s\"", cmRef.Key, cmRef.Name)
+		return nil, fmt.Errorf("key \"%s\" in ConfigMap \"%s\": %w", cmRef.Key, cmRef.Name, ErrMissingKey)
 	}
 	return &val, nil
 }
 
-func getValueFromKrakenResource[T any](
+// getValueFromSecret always fails: DependentValues has no Secret-backed
+// counterpart to ConfigMaps upstream yet (no DependentValuesFromSecrets
+// field exists to read from), so Secret sources cannot be resolved until
+// that lands in github.com/kraken-iac/kraken.
+func getValueFromSecret(secretRef *ValueFromSecret) (*string, error) {
+	return nil, fmt.Errorf("Secret \"%s\": %w", secretRef.Name, ErrUnsupportedSource)
+}
+
+func getRawValueFromKrakenResource(
 	krRef *ValueFromKrakenResource,
 	krVals krakenv1alpha1.DependentValuesFromKrakenResources,
-) (*T, error) {
+) (any, error) {
 	kind, exists := krVals[krRef.Kind]
 	if !exists {
-		return nil, fmt.Errorf("no entry for kind \"%s\" in DependentValues", krRef.Kind)
+		return nil, fmt.Errorf("kind \"%s\": %w", krRef.Kind, ErrMissingKrakenKind)
 	}
 	resource, exists := kind[krRef.Name]
 	if !exists {
-		return nil, fmt.Errorf("no entry for resource \"%s\" in DependentValues", krRef.Name)
+		return nil, fmt.Errorf("resource \"%s\": %w", krRef.Name, ErrMissingKrakenName)
+	}
+
+	syntax := krRef.effectivePathSyntax()
+	lookupKey := krRef.Path
+	if syntax != PathSyntaxKey {
+		lookupKey = wholeResourceKey
 	}
-	jsonVal, exists := resource[krRef.Path]
+	jsonVal, exists := resource[lookupKey]
 	if !exists {
-		return nil, fmt.Errorf("no entry for path \"%s\" in DependentValues", krRef.Path)
+		return nil, fmt.Errorf("path \"%s\": %w", krRef.Path, ErrMissingKrakenPath)
 	}
 
 	jsonContainer, err := gabs.ParseJSON(jsonVal.Raw)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing JSON: %s", err)
 	}
-	data := jsonContainer.Data()
+
+	switch syntax {
+	case PathSyntaxKey:
+		return jsonContainer.Data(), nil
+	case PathSyntaxJSONPointer:
+		node, err := jsonContainer.JSONPointer(krRef.Path)
+		if err != nil {
+			return nil, fmt.Errorf("resolving JSON pointer \"%s\": %s", krRef.Path, err)
+		}
+		if node.Data() == nil {
+			return nil, fmt.Errorf("JSON pointer \"%s\": %w", krRef.Path, ErrMissingKrakenPath)
+		}
+		return node.Data(), nil
+	case PathSyntaxGabs:
+		node := jsonContainer.Path(krRef.Path)
+		if node.Data() == nil {
+			return nil, fmt.Errorf("gabs path \"%s\": %w", krRef.Path, ErrMissingKrakenPath)
+		}
+		return node.Data(), nil
+	case PathSyntaxJSONPath:
+		data, err := resolveJSONPath(jsonContainer.Data(), krRef.Path)
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			return nil, fmt.Errorf("JSONPath \"%s\": %w", krRef.Path, ErrMissingKrakenPath)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("\"%s\": %w", syntax, ErrUnknownPathSyntax)
+	}
+}
+
+func resolveJSONPath(data any, path string) (any, error) {
+	jp := jsonpath.New("value")
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("parsing JSONPath \"%s\": %s", path, err)
+	}
+	results, err := jp.FindResults(data)
+	if err != nil {
+		return nil, fmt.Errorf("evaluating JSONPath \"%s\": %s", path, err)
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return nil, fmt.Errorf("JSONPath \"%s\": %w", path, ErrMissingKrakenPath)
+	}
+	return results[0][0].Interface(), nil
+}
+
+func getValueFromKrakenResource[T any](
+	krRef *ValueFromKrakenResource,
+	krVals krakenv1alpha1.DependentValuesFromKrakenResources,
+) (*T, error) {
+	data, err := getRawValueFromKrakenResource(krRef, krVals)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("path \"%s\": %w", krRef.Path, ErrMissingKrakenPath)
+	}
 
 	var val T
 	expectedType := reflect.TypeOf(val).Kind()
 	actualType := reflect.TypeOf(data).Kind()
 	if actualType != expectedType {
 		return nil, fmt.Errorf(
-			"provided value \"%s\" is of type \"%s\"; expected type \"%s\"",
+			"provided value \"%s\": %w",
 			data,
-			actualType,
-			expectedType,
+			&ErrTypeMismatch{Expected: expectedType, Actual: actualType},
 		)
 	}
 
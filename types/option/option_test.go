@@ -0,0 +1,181 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package option
+
+import (
+	"errors"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	krakenv1alpha1 "github.com/kraken-iac/kraken/api/v1alpha1"
+)
+
+// Secret sources have no DependentValues-backed counterpart upstream yet
+// (see getValueFromSecret), so String/Int resolution from a Secret always
+// fails with ErrUnsupportedSource rather than coercing decoded bytes.
+
+func TestStringToApplicableValue_FromSecret_Unsupported(t *testing.T) {
+	s := String{
+		ValueFrom: &ValueFrom{Secret: &ValueFromSecret{Name: "my-secret", Key: "my-key"}},
+	}
+
+	if _, err := s.ToApplicableValue(krakenv1alpha1.DependentValues{}); !errors.Is(err, ErrUnsupportedSource) {
+		t.Fatalf("expected ErrUnsupportedSource, got %v", err)
+	}
+}
+
+func TestIntToApplicableValue_FromSecret_Unsupported(t *testing.T) {
+	i := Int{
+		ValueFrom: &ValueFrom{Secret: &ValueFromSecret{Name: "my-secret", Key: "my-key"}},
+	}
+
+	if _, err := i.ToApplicableValue(krakenv1alpha1.DependentValues{}); !errors.Is(err, ErrUnsupportedSource) {
+		t.Fatalf("expected ErrUnsupportedSource, got %v", err)
+	}
+}
+
+func TestValueFromSecretValidate(t *testing.T) {
+	cases := map[string]struct {
+		vfs     ValueFromSecret
+		wantErr error
+	}{
+		"valid":          {ValueFromSecret{Name: "n", Key: "k"}, nil},
+		"empty name":     {ValueFromSecret{Key: "k"}, ErrEmptyField},
+		"empty key":      {ValueFromSecret{Name: "n"}, ErrEmptyField},
+		"empty name/key": {ValueFromSecret{}, ErrEmptyField},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.vfs.Validate()
+			if tc.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error wrapping %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValueFromValidate_DelegatesToSecret(t *testing.T) {
+	vf := ValueFrom{Secret: &ValueFromSecret{}}
+	if err := vf.Validate(); !errors.Is(err, ErrEmptyField) {
+		t.Fatalf("expected ErrEmptyField from the wrapped ValueFromSecret, got %v", err)
+	}
+}
+
+func krakenResourceDependentValues(kind, name, key string, raw []byte) krakenv1alpha1.DependentValues {
+	return krakenv1alpha1.DependentValues{
+		FromKrakenResources: krakenv1alpha1.DependentValuesFromKrakenResources{
+			kind: {name: {key: apiextensionsv1.JSON{Raw: raw}}},
+		},
+	}
+}
+
+func TestGetValueFromKrakenResource_NestedPathAndArrayIndexing(t *testing.T) {
+	raw := []byte(`{"spec":{"replicas":3,"containers":["api","worker"]}}`)
+	dv := krakenResourceDependentValues("Widget", "my-widget", wholeResourceKey, raw)
+
+	replicas, err := getValueFromKrakenResource[float64](
+		&ValueFromKrakenResource{Kind: "Widget", Name: "my-widget", Path: "/spec/replicas", PathSyntax: PathSyntaxJSONPointer},
+		dv.FromKrakenResources,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replicas == nil || *replicas != 3 {
+		t.Fatalf("expected 3, got %v", replicas)
+	}
+
+	container, err := getValueFromKrakenResource[string](
+		&ValueFromKrakenResource{Kind: "Widget", Name: "my-widget", Path: "{.spec.containers[1]}", PathSyntax: PathSyntaxJSONPath},
+		dv.FromKrakenResources,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container == nil || *container != "worker" {
+		t.Fatalf("expected \"worker\", got %v", container)
+	}
+}
+
+func TestGetValueFromKrakenResource_TypeMismatch(t *testing.T) {
+	raw := []byte(`{"spec":{"replicas":3}}`)
+	dv := krakenResourceDependentValues("Widget", "my-widget", wholeResourceKey, raw)
+
+	_, err := getValueFromKrakenResource[string](
+		&ValueFromKrakenResource{Kind: "Widget", Name: "my-widget", Path: "/spec/replicas", PathSyntax: PathSyntaxJSONPointer},
+		dv.FromKrakenResources,
+	)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	var typeMismatch *ErrTypeMismatch
+	if !errors.As(err, &typeMismatch) {
+		t.Fatalf("expected *ErrTypeMismatch, got %v (%T)", err, err)
+	}
+}
+
+// A CEL expression that legitimately evaluates to null must produce an
+// ErrTypeMismatch, not a panic.
+func TestResolveFromExpression_NullResultIsTypeMismatchNotPanic(t *testing.T) {
+	dv := krakenv1alpha1.DependentValues{}
+	exprVF := func() *ValueFrom {
+		return &ValueFrom{Expression: &ValueFromExpression{Expression: "null"}}
+	}
+
+	cases := map[string]func() (any, error){
+		"Bool": func() (any, error) {
+			return (Bool{ValueFrom: exprVF()}).ToApplicableValue(dv)
+		},
+		"Float64": func() (any, error) {
+			return (Float64{ValueFrom: exprVF()}).ToApplicableValue(dv)
+		},
+		"Duration": func() (any, error) {
+			return (Duration{ValueFrom: exprVF()}).ToApplicableValue(dv)
+		},
+	}
+	for name, resolve := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := resolve()
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			var mismatch *ErrTypeMismatch
+			if !errors.As(err, &mismatch) {
+				t.Fatalf("expected *ErrTypeMismatch, got %v (%T)", err, err)
+			}
+		})
+	}
+}
+
+func TestGetValueFromKrakenResource_NullPathIsMissingDependency(t *testing.T) {
+	raw := []byte(`{"spec":{"replicas":null}}`)
+	dv := krakenResourceDependentValues("Widget", "my-widget", wholeResourceKey, raw)
+
+	_, err := getValueFromKrakenResource[float64](
+		&ValueFromKrakenResource{Kind: "Widget", Name: "my-widget", Path: "/spec/replicas", PathSyntax: PathSyntaxJSONPointer},
+		dv.FromKrakenResources,
+	)
+	if !errors.Is(err, ErrMissingKrakenPath) {
+		t.Fatalf("expected ErrMissingKrakenPath, got %v", err)
+	}
+	if !IsMissingDependency(err) {
+		t.Fatal("expected IsMissingDependency to report true for a null path value")
+	}
+}